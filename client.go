@@ -0,0 +1,176 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Client is a handle to the Datadog API.
+type Client struct {
+	apiKey, appKey string
+	baseUrl        string
+	HttpClient     *http.Client
+	// ExtraHeader is sent on every request, handy for custom proxies.
+	ExtraHeader map[string]string
+
+	retryPolicy RetryPolicy
+}
+
+// NewClient returns a Client talking to the public Datadog API with the
+// given credentials.
+func NewClient(apiKey, appKey string) *Client {
+	return &Client{
+		apiKey:     apiKey,
+		appKey:     appKey,
+		baseUrl:    "https://api.datadoghq.com",
+		HttpClient: http.DefaultClient,
+	}
+}
+
+// APIError is returned by doJSONRequest/doJSONRequestCtx when Datadog
+// responds with a status of 400 or above, carrying the raw response body so callers
+// that need the structured error payload (e.g. ValidateMonitor) can decode
+// it themselves.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, string(e.Body))
+}
+
+// uriForAPI builds the full request URL for the given API path, adding the
+// client's api_key/application_key query parameters alongside any the
+// caller already encoded into api.
+func (client *Client) uriForAPI(api string) (string, error) {
+	u, err := url.Parse(client.baseUrl + api)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("api_key", client.apiKey)
+	q.Set("application_key", client.appKey)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// doJSONRequest performs a JSON request/response round-trip against the
+// Datadog API. See doJSONRequestCtx.
+func (client *Client) doJSONRequest(method, api string, body, out interface{}) error {
+	return client.doJSONRequestCtx(context.Background(), method, api, body, out)
+}
+
+// doJSONRequestCtx performs a JSON request/response round-trip against the
+// Datadog API, observing ctx's cancellation and deadline. body, if non-nil,
+// is marshaled once and buffered so it can be replayed across retries; out,
+// if non-nil, receives the unmarshaled response body. Retries are governed
+// by the client's RetryPolicy (see WithRetryPolicy), which defaults to an
+// *ExponentialBackoff.
+func (client *Client) doJSONRequestCtx(ctx context.Context, method, api string, body, out interface{}) error {
+	var bodyBytes []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyBytes = b
+	}
+
+	uri, err := client.uriForAPI(api)
+	if err != nil {
+		return err
+	}
+
+	httpClient := client.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	policy := client.retryPolicy
+	if policy == nil {
+		policy = &ExponentialBackoff{}
+	}
+
+	for attempt := 1; ; attempt++ {
+		var reader io.Reader
+		if bodyBytes != nil {
+			reader = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, uri, reader)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range client.ExtraHeader {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			if retry, wait := policy.ShouldRetry(attempt, nil, err); retry {
+				if waitErr := sleepCtx(ctx, wait); waitErr != nil {
+					return waitErr
+				}
+				continue
+			}
+			return err
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= 400 {
+			apiErr := &APIError{StatusCode: resp.StatusCode, Body: respBody}
+			if retry, wait := policy.ShouldRetry(attempt, resp, apiErr); retry {
+				if waitErr := sleepCtx(ctx, wait); waitErr != nil {
+					return waitErr
+				}
+				continue
+			}
+			return apiErr
+		}
+
+		if out != nil && len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// sleepCtx waits for d, returning early with ctx's error if ctx is canceled
+// or expires first.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
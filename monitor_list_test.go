@@ -0,0 +1,83 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGetMonitorsBuildsQueryFromOptions(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Encode()
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	withDowntimes := true
+	_, err := client.GetMonitors(ListMonitorsOptions{
+		GroupStates:   []string{"alert", "warn"},
+		Name:          "cpu",
+		Tags:          []string{"env:prod"},
+		MonitorTags:   []string{"team:core"},
+		WithDowntimes: &withDowntimes,
+		IDOffset:      42,
+	})
+	if err != nil {
+		t.Fatalf("GetMonitors: %v", err)
+	}
+
+	q, err := url.ParseQuery(gotQuery)
+	if err != nil {
+		t.Fatalf("ParseQuery: %v", err)
+	}
+
+	checks := map[string]string{
+		"group_states":   "alert,warn",
+		"name":           "cpu",
+		"tags":           "env:prod",
+		"monitor_tags":   "team:core",
+		"with_downtimes": "true",
+		"id_offset":      "42",
+	}
+	for key, want := range checks {
+		if got := q.Get(key); got != want {
+			t.Errorf("query[%q] = %q, want %q", key, got, want)
+		}
+	}
+	if q.Has("page_size") || q.Has("count") {
+		t.Errorf("query = %q, should not send a page_size/count parameter", gotQuery)
+	}
+}
+
+func TestGetMonitorsNoOptionsSendsNoQuery(t *testing.T) {
+	var gotRawQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRawQuery = r.URL.RawQuery
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	if _, err := client.GetMonitors(); err != nil {
+		t.Fatalf("GetMonitors: %v", err)
+	}
+
+	if gotRawQuery != "api_key=test-api-key&application_key=test-app-key" {
+		t.Errorf("RawQuery = %q, want only the client's own api/application key params", gotRawQuery)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MonitorSearchQuery represents the parameters accepted by `/v1/monitor/search`.
+type MonitorSearchQuery struct {
+	Query   string
+	Page    *int
+	PerPage *int
+	Sort    *string
+}
+
+// MonitorSearchCounts holds the faceted counts returned alongside a monitor
+// search result, keyed by facet name.
+type MonitorSearchCounts struct {
+	Status []MonitorSearchCount `json:"status,omitempty"`
+	Type   []MonitorSearchCount `json:"type,omitempty"`
+	Tag    []MonitorSearchCount `json:"tag,omitempty"`
+	Muted  []MonitorSearchCount `json:"muted,omitempty"`
+}
+
+// MonitorSearchCount is a single facet bucket, e.g. {"name": "Alert", "count": 3}.
+type MonitorSearchCount struct {
+	Name  *string `json:"name,omitempty"`
+	Count *int    `json:"count,omitempty"`
+}
+
+// MonitorSearchGroupState describes the current alerting state of a single
+// group within a monitor.
+type MonitorSearchGroupState struct {
+	Group           *string `json:"group,omitempty"`
+	Status          *string `json:"status,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	LastNodataTs    *int    `json:"last_nodata_ts,omitempty"`
+	LastTriggeredTs *int    `json:"last_triggered_ts,omitempty"`
+}
+
+// MonitorSearchResultItem is a single monitor as returned by SearchMonitors,
+// carrying the current alert state alongside the monitor's identifying
+// fields so callers can act on it without a second round-trip.
+type MonitorSearchResultItem struct {
+	ID           *int                      `json:"id,omitempty"`
+	Name         *string                   `json:"name,omitempty"`
+	Type         *string                   `json:"type,omitempty"`
+	Tags         []string                  `json:"tags,omitempty"`
+	Query        *string                   `json:"query,omitempty"`
+	OverallState *string                   `json:"overall_state,omitempty"`
+	Status       *string                   `json:"status,omitempty"`
+	GroupStates  []MonitorSearchGroupState `json:"group_states,omitempty"`
+}
+
+// MonitorSearchMetadata holds the paging metadata of a search result.
+type MonitorSearchMetadata struct {
+	Page       *int `json:"page,omitempty"`
+	PageCount  *int `json:"page_count,omitempty"`
+	PerPage    *int `json:"per_page,omitempty"`
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// MonitorSearchResult is the response of `/v1/monitor/search`.
+type MonitorSearchResult struct {
+	Monitors []MonitorSearchResultItem `json:"monitors,omitempty"`
+	Counts   MonitorSearchCounts       `json:"counts,omitempty"`
+	Facets   map[string]interface{}    `json:"facets,omitempty"`
+	Metadata MonitorSearchMetadata     `json:"metadata,omitempty"`
+}
+
+// SearchMonitors searches and paginates over monitors via `/v1/monitor/search`,
+// combining free-text query, tag, and paging filters that GetMonitorsByName
+// and GetMonitorsByTags cannot express together. The result surfaces each
+// monitor's overall_state, status, and per-group group_states so callers can
+// react to alert state without fetching each monitor individually.
+func (client *Client) SearchMonitors(query MonitorSearchQuery) (*MonitorSearchResult, error) {
+	var out MonitorSearchResult
+
+	values := url.Values{}
+	if query.Query != "" {
+		values.Add("query", query.Query)
+	}
+	if query.Page != nil {
+		values.Add("page", strconv.Itoa(*query.Page))
+	}
+	if query.PerPage != nil {
+		values.Add("per_page", strconv.Itoa(*query.PerPage))
+	}
+	if query.Sort != nil {
+		values.Add("sort", *query.Sort)
+	}
+
+	uri := "/v1/monitor/search"
+	if len(values) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, values.Encode())
+	}
+
+	if err := client.doJSONRequest("GET", uri, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
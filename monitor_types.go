@@ -0,0 +1,96 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MonitorType identifies the kind of check a Monitor performs, matching the
+// `type` field accepted by Datadog's monitor endpoints.
+type MonitorType string
+
+// The monitor types supported by the Datadog API.
+//
+// Anomaly detection monitors are query alerts whose query embeds an
+// anomaly-detection algorithm rather than a distinct server-side type, so
+// there is no MonitorTypeAnomalyDetection constant here: a monitor's Type
+// alone can never distinguish one from a plain MonitorTypeQueryAlert. Inspect
+// Query (e.g. for "anomalies(") if you need to tell them apart.
+const (
+	MonitorTypeMetricAlert    MonitorType = "metric alert"
+	MonitorTypeServiceCheck   MonitorType = "service check"
+	MonitorTypeEventAlert     MonitorType = "event alert"
+	MonitorTypeQueryAlert     MonitorType = "query alert"
+	MonitorTypeCompositeAlert MonitorType = "composite"
+	MonitorTypeLogAlert       MonitorType = "log alert"
+	MonitorTypeProcessAlert   MonitorType = "process alert"
+)
+
+// GetType returns the monitor's MonitorType, or the zero value if Type is
+// unset.
+func (m *Monitor) GetType() MonitorType {
+	if m.Type == nil {
+		return ""
+	}
+	return MonitorType(*m.Type)
+}
+
+// SetType sets the monitor's Type from a MonitorType constant.
+func (m *Monitor) SetType(t MonitorType) {
+	s := string(t)
+	m.Type = &s
+}
+
+// GetType returns the search result's MonitorType, or the zero value if Type
+// is unset.
+func (item *MonitorSearchResultItem) GetType() MonitorType {
+	if item.Type == nil {
+		return ""
+	}
+	return MonitorType(*item.Type)
+}
+
+// ValidationError reports the messages returned by `/v1/monitor/validate`
+// when a monitor fails validation. Datadog's validate endpoint returns a
+// flat list of messages rather than a field-keyed object, so Errors mirrors
+// that shape directly.
+type ValidationError struct {
+	Errors []string `json:"errors"`
+}
+
+// Error implements the error interface, summarizing all validation messages.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("monitor validation failed: %s", strings.Join(e.Errors, "; "))
+}
+
+// ValidateMonitor checks a monitor definition against `/v1/monitor/validate`
+// without creating it. Datadog responds with HTTP 400 and a JSON body of
+// {"errors": [...]} when the monitor is invalid; ValidateMonitor decodes
+// that body into a *ValidationError. CreateMonitor and UpdateMonitor callers
+// should call this first to catch mistakes (e.g. a malformed query for the
+// monitor's type) before writing to the API.
+func (client *Client) ValidateMonitor(monitor *Monitor) error {
+	err := client.doJSONRequest("POST", "/v1/monitor/validate", monitor, nil)
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == 400 {
+		var valErr ValidationError
+		if jsonErr := json.Unmarshal(apiErr.Body, &valErr); jsonErr == nil && len(valErr.Errors) > 0 {
+			return &valErr
+		}
+	}
+	return err
+}
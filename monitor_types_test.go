@@ -0,0 +1,68 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMonitorGetSetType(t *testing.T) {
+	var m Monitor
+	if got := m.GetType(); got != "" {
+		t.Errorf("GetType() on zero-value Monitor = %q, want empty", got)
+	}
+
+	m.SetType(MonitorTypeLogAlert)
+	if got := m.GetType(); got != MonitorTypeLogAlert {
+		t.Errorf("GetType() = %q, want %q", got, MonitorTypeLogAlert)
+	}
+	if m.Type == nil || *m.Type != "log alert" {
+		t.Errorf("Type = %v, want \"log alert\"", m.Type)
+	}
+}
+
+func TestValidateMonitorDecodes400Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors": ["query is invalid", "name is required"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	err := client.ValidateMonitor(&Monitor{})
+	if err == nil {
+		t.Fatal("expected an error for an invalid monitor")
+	}
+
+	valErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("err = %T, want *ValidationError", err)
+	}
+	if len(valErr.Errors) != 2 || valErr.Errors[0] != "query is invalid" || valErr.Errors[1] != "name is required" {
+		t.Errorf("Errors = %v, want [query is invalid, name is required]", valErr.Errors)
+	}
+}
+
+func TestValidateMonitorOK(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	if err := client.ValidateMonitor(&Monitor{}); err != nil {
+		t.Errorf("ValidateMonitor: %v, want nil", err)
+	}
+}
@@ -9,11 +9,9 @@
 package datadog
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"net/url"
 	"strconv"
-	"strings"
 )
 
 // ThresholdCount represents an object of various threshold settings applicable to metric alerts.
@@ -45,19 +43,91 @@ func (tf *NoDataTimeframe) UnmarshalJSON(data []byte) error {
 
 // Options represents a dictionary of settings for the monitor.
 type Options struct {
-	NoDataTimeframe   NoDataTimeframe `json:"no_data_timeframe,omitempty"`
-	NotifyAudit       *bool           `json:"notify_audit,omitempty"`
-	NotifyNoData      *bool           `json:"notify_no_data,omitempty"`
-	RenotifyInterval  *int            `json:"renotify_interval,omitempty"`
-	NewHostDelay      *int            `json:"new_host_delay,omitempty"`
-	EvaluationDelay   *int            `json:"evaluation_delay,omitempty"`
-	Silenced          map[string]int  `json:"silenced,omitempty"`
-	TimeoutH          *int            `json:"timeout_h,omitempty"`
-	EscalationMessage *string         `json:"escalation_message,omitempty"`
-	Thresholds        *ThresholdCount `json:"thresholds,omitempty"`
-	IncludeTags       *bool           `json:"include_tags,omitempty"`
-	RequireFullWindow *bool           `json:"require_full_window,omitempty"`
-	Locked            *bool           `json:"locked,omitempty"`
+	NoDataTimeframe        NoDataTimeframe          `json:"no_data_timeframe,omitempty"`
+	NotifyAudit            *bool                    `json:"notify_audit,omitempty"`
+	NotifyNoData           *bool                    `json:"notify_no_data,omitempty"`
+	RenotifyInterval       *int                     `json:"renotify_interval,omitempty"`
+	NewHostDelay           *int                     `json:"new_host_delay,omitempty"`
+	EvaluationDelay        *int                     `json:"evaluation_delay,omitempty"`
+	Silenced               map[string]int           `json:"silenced,omitempty"`
+	TimeoutH               *int                     `json:"timeout_h,omitempty"`
+	EscalationMessage      *string                  `json:"escalation_message,omitempty"`
+	Thresholds             *ThresholdCount          `json:"thresholds,omitempty"`
+	IncludeTags            *bool                    `json:"include_tags,omitempty"`
+	RequireFullWindow      *bool                    `json:"require_full_window,omitempty"`
+	Locked                 *bool                    `json:"locked,omitempty"`
+	QueryConfig            *QueryConfig             `json:"query_config,omitempty"`
+	EnableLogsSample       *bool                    `json:"enable_logs_sample,omitempty"`
+	GroupbySimpleMonitor   *bool                    `json:"groupby_simple_monitor,omitempty"`
+	NotifyBy               []string                 `json:"notify_by,omitempty"`
+	OnMissingData          *string                  `json:"on_missing_data,omitempty"`
+	NotificationPresetName *string                  `json:"notification_preset_name,omitempty"`
+	SchedulingOptions      *SchedulingOptions       `json:"scheduling_options,omitempty"`
+	Variables              []MonitorFormulaVariable `json:"variables,omitempty"`
+}
+
+// QueryConfig holds type-specific query tuning, currently the threshold
+// windows used by anomaly detection monitors.
+type QueryConfig struct {
+	ThresholdWindows *ThresholdWindows `json:"threshold_windows,omitempty"`
+}
+
+// ThresholdWindows configures how long an anomaly must persist before a
+// monitor triggers or recovers.
+type ThresholdWindows struct {
+	TriggerWindow  *string `json:"trigger_window,omitempty"`
+	RecoveryWindow *string `json:"recovery_window,omitempty"`
+}
+
+// SchedulingOptions controls when a monitor is evaluated, e.g. restricting
+// evaluation to business hours.
+type SchedulingOptions struct {
+	EvaluationWindow *EvaluationWindow `json:"evaluation_window,omitempty"`
+}
+
+// EvaluationWindow bounds the days, hours, and months during which a monitor
+// is evaluated.
+type EvaluationWindow struct {
+	DayStarts   *string `json:"day_starts,omitempty"`
+	HourStarts  *int    `json:"hour_starts,omitempty"`
+	MonthStarts *int    `json:"month_starts,omitempty"`
+}
+
+// MonitorFormulaVariable is a single named variable used by formula/function
+// monitors.
+type MonitorFormulaVariable struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// NewAnomalyDetectionOptions builds the Options for an anomaly detection
+// monitor, wiring the threshold window sub-structs so callers don't have to
+// know the nested QueryConfig shape.
+func NewAnomalyDetectionOptions(triggerWindow, recoveryWindow string) *Options {
+	return &Options{
+		QueryConfig: &QueryConfig{
+			ThresholdWindows: &ThresholdWindows{
+				TriggerWindow:  &triggerWindow,
+				RecoveryWindow: &recoveryWindow,
+			},
+		},
+	}
+}
+
+// SilenceScope silences a single scope (e.g. "host:foo") on the monitor until
+// the given UNIX timestamp. Use "*" as the scope to silence the monitor for
+// all scopes.
+func (o *Options) SilenceScope(scope string, end int64) {
+	if o.Silenced == nil {
+		o.Silenced = make(map[string]int)
+	}
+	o.Silenced[scope] = int(end)
+}
+
+// SilenceAllScopes silences the monitor for every scope until the given UNIX
+// timestamp, equivalent to SilenceScope("*", end).
+func (o *Options) SilenceAllScopes(end int64) {
+	o.SilenceScope("*", end)
 }
 
 // Monitor allows watching a metric or check that you care about,
@@ -89,91 +159,90 @@ type reqMonitors struct {
 // CreateMonitor adds a new monitor to the system. This returns a pointer to a
 // monitor so you can pass that to UpdateMonitor later if needed
 func (client *Client) CreateMonitor(monitor *Monitor) (*Monitor, error) {
-	var out Monitor
-	// TODO: is this more pretty of frowned upon?
-	if err := client.doJSONRequest("POST", "/v1/monitor", monitor, &out); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return client.CreateMonitorCtx(context.Background(), monitor)
 }
 
 // UpdateMonitor takes a monitor that was previously retrieved through some method
 // and sends it back to the server
 func (client *Client) UpdateMonitor(monitor *Monitor) error {
-	return client.doJSONRequest("PUT", fmt.Sprintf("/v1/monitor/%d", *monitor.ID),
-		monitor, nil)
+	return client.UpdateMonitorCtx(context.Background(), monitor)
 }
 
 // GetMonitor retrieves a monitor by identifier
 func (client *Client) GetMonitor(id int) (*Monitor, error) {
-	var out Monitor
-	if err := client.doJSONRequest("GET", fmt.Sprintf("/v1/monitor/%d", id), nil, &out); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return client.GetMonitorCtx(context.Background(), id)
 }
 
 // GetMonitorsByName retrieves monitors by name
 func (client *Client) GetMonitorsByName(name string) ([]Monitor, error) {
-	var out reqMonitors
-	query, err := url.ParseQuery(fmt.Sprintf("name=%v", name))
-	if err != nil {
-		return nil, err
-	}
-
-	err = client.doJSONRequest("GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
-	if err != nil {
-		return nil, err
-	}
-	return out.Monitors, nil
+	return client.GetMonitorsByNameCtx(context.Background(), name)
 }
 
 // GetMonitorsByTags retrieves monitors by a slice of tags
 func (client *Client) GetMonitorsByTags(tags []string) ([]Monitor, error) {
-	var out reqMonitors
-	query, err := url.ParseQuery(fmt.Sprintf("monitor_tags=%v", strings.Join(tags, ",")))
-	if err != nil {
-		return nil, err
-	}
-
-	err = client.doJSONRequest("GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
-	if err != nil {
-		return nil, err
-	}
-	return out.Monitors, nil
+	return client.GetMonitorsByTagsCtx(context.Background(), tags)
 }
 
 // DeleteMonitor removes a monitor from the system
 func (client *Client) DeleteMonitor(id int) error {
-	return client.doJSONRequest("DELETE", fmt.Sprintf("/v1/monitor/%d", id),
-		nil, nil)
+	return client.DeleteMonitorCtx(context.Background(), id)
 }
 
-// GetMonitors returns a slice of all monitors
-func (client *Client) GetMonitors() ([]Monitor, error) {
-	var out reqMonitors
-	if err := client.doJSONRequest("GET", "/v1/monitor", nil, &out.Monitors); err != nil {
-		return nil, err
-	}
-	return out.Monitors, nil
+// ListMonitorsOptions holds the filters and paging parameters accepted by
+// GetMonitors, matching the documented query parameters of `GET /v1/monitor`.
+// Note that endpoint only supports offset-based paging via IDOffset; unlike
+// `/v1/monitor/search` it has no page-size parameter, so there is no Count
+// field here.
+type ListMonitorsOptions struct {
+	GroupStates   []string
+	Name          string
+	Tags          []string
+	MonitorTags   []string
+	WithDowntimes *bool
+	IDOffset      int
+}
+
+// GetMonitors returns a slice of all monitors. An optional ListMonitorsOptions
+// narrows the result to matching names/tags, includes group states, and pages
+// through large monitor sets via IDOffset.
+func (client *Client) GetMonitors(opts ...ListMonitorsOptions) ([]Monitor, error) {
+	return client.GetMonitorsCtx(context.Background(), opts...)
 }
 
 // MuteMonitors turns off monitoring notifications
 func (client *Client) MuteMonitors() error {
-	return client.doJSONRequest("POST", "/v1/monitor/mute_all", nil, nil)
+	return client.MuteMonitorsCtx(context.Background())
 }
 
 // UnmuteMonitors turns on monitoring notifications
 func (client *Client) UnmuteMonitors() error {
-	return client.doJSONRequest("POST", "/v1/monitor/unmute_all", nil, nil)
+	return client.UnmuteMonitorsCtx(context.Background())
+}
+
+// MuteMonitorOptions represents the optional scope and end time accepted by
+// the mute endpoint, mirroring Datadog's documented `/v1/monitor/{id}/mute`
+// parameters.
+type MuteMonitorOptions struct {
+	Scope *string `json:"scope,omitempty"`
+	End   *int64  `json:"end,omitempty"`
+}
+
+// MuteMonitor turns off monitoring notifications for a monitor. Passing a
+// MuteMonitorOptions scopes the mute to a specific tag (e.g. "host:foo") and/or
+// schedules it to automatically expire at the given UNIX timestamp. Omitting
+// the options preserves the previous behavior of muting the monitor entirely
+// with no expiration.
+func (client *Client) MuteMonitor(id int, opts ...MuteMonitorOptions) error {
+	return client.MuteMonitorCtx(context.Background(), id, opts...)
 }
 
-// MuteMonitor turns off monitoring notifications for a monitor
-func (client *Client) MuteMonitor(id int) error {
-	return client.doJSONRequest("POST", fmt.Sprintf("/v1/monitor/%d/mute", id), nil, nil)
+// MuteMonitorScope mutes a monitor for a single scope (e.g. "host:foo" or
+// "env:prod"), optionally expiring the mute at the given UNIX timestamp.
+func (client *Client) MuteMonitorScope(id int, scope string, end *int64) error {
+	return client.MuteMonitor(id, MuteMonitorOptions{Scope: &scope, End: end})
 }
 
 // UnmuteMonitor turns on monitoring notifications for a monitor
 func (client *Client) UnmuteMonitor(id int) error {
-	return client.doJSONRequest("POST", fmt.Sprintf("/v1/monitor/%d/unmute", id), nil, nil)
+	return client.UnmuteMonitorCtx(context.Background(), id)
 }
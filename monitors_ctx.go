@@ -0,0 +1,152 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CreateMonitorCtx adds a new monitor to the system, observing ctx's
+// cancellation and deadline. See CreateMonitor.
+func (client *Client) CreateMonitorCtx(ctx context.Context, monitor *Monitor) (*Monitor, error) {
+	var out Monitor
+	if err := client.doJSONRequestCtx(ctx, "POST", "/v1/monitor", monitor, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMonitorCtx takes a monitor that was previously retrieved through some
+// method and sends it back to the server, observing ctx's cancellation and
+// deadline. See UpdateMonitor.
+func (client *Client) UpdateMonitorCtx(ctx context.Context, monitor *Monitor) error {
+	return client.doJSONRequestCtx(ctx, "PUT", fmt.Sprintf("/v1/monitor/%d", *monitor.ID),
+		monitor, nil)
+}
+
+// GetMonitorCtx retrieves a monitor by identifier, observing ctx's
+// cancellation and deadline. See GetMonitor.
+func (client *Client) GetMonitorCtx(ctx context.Context, id int) (*Monitor, error) {
+	var out Monitor
+	if err := client.doJSONRequestCtx(ctx, "GET", fmt.Sprintf("/v1/monitor/%d", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMonitorsByNameCtx retrieves monitors by name, observing ctx's
+// cancellation and deadline. See GetMonitorsByName.
+func (client *Client) GetMonitorsByNameCtx(ctx context.Context, name string) ([]Monitor, error) {
+	var out reqMonitors
+	query, err := url.ParseQuery(fmt.Sprintf("name=%v", name))
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.doJSONRequestCtx(ctx, "GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
+	if err != nil {
+		return nil, err
+	}
+	return out.Monitors, nil
+}
+
+// GetMonitorsByTagsCtx retrieves monitors by a slice of tags, observing ctx's
+// cancellation and deadline. See GetMonitorsByTags.
+func (client *Client) GetMonitorsByTagsCtx(ctx context.Context, tags []string) ([]Monitor, error) {
+	var out reqMonitors
+	query, err := url.ParseQuery(fmt.Sprintf("monitor_tags=%v", strings.Join(tags, ",")))
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.doJSONRequestCtx(ctx, "GET", fmt.Sprintf("/v1/monitor?%v", query.Encode()), nil, &out.Monitors)
+	if err != nil {
+		return nil, err
+	}
+	return out.Monitors, nil
+}
+
+// DeleteMonitorCtx removes a monitor from the system, observing ctx's
+// cancellation and deadline. See DeleteMonitor.
+func (client *Client) DeleteMonitorCtx(ctx context.Context, id int) error {
+	return client.doJSONRequestCtx(ctx, "DELETE", fmt.Sprintf("/v1/monitor/%d", id),
+		nil, nil)
+}
+
+// GetMonitorsCtx returns a slice of all monitors, observing ctx's
+// cancellation and deadline. See GetMonitors.
+func (client *Client) GetMonitorsCtx(ctx context.Context, opts ...ListMonitorsOptions) ([]Monitor, error) {
+	var out reqMonitors
+	query := url.Values{}
+	if len(opts) > 0 {
+		o := opts[0]
+		if len(o.GroupStates) > 0 {
+			query.Add("group_states", strings.Join(o.GroupStates, ","))
+		}
+		if o.Name != "" {
+			query.Add("name", o.Name)
+		}
+		if len(o.Tags) > 0 {
+			query.Add("tags", strings.Join(o.Tags, ","))
+		}
+		if len(o.MonitorTags) > 0 {
+			query.Add("monitor_tags", strings.Join(o.MonitorTags, ","))
+		}
+		if o.WithDowntimes != nil {
+			query.Add("with_downtimes", strconv.FormatBool(*o.WithDowntimes))
+		}
+		if o.IDOffset != 0 {
+			query.Add("id_offset", strconv.Itoa(o.IDOffset))
+		}
+	}
+
+	uri := "/v1/monitor"
+	if len(query) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, query.Encode())
+	}
+	if err := client.doJSONRequestCtx(ctx, "GET", uri, nil, &out.Monitors); err != nil {
+		return nil, err
+	}
+	return out.Monitors, nil
+}
+
+// MuteMonitorsCtx turns off monitoring notifications, observing ctx's
+// cancellation and deadline. See MuteMonitors.
+func (client *Client) MuteMonitorsCtx(ctx context.Context) error {
+	return client.doJSONRequestCtx(ctx, "POST", "/v1/monitor/mute_all", nil, nil)
+}
+
+// UnmuteMonitorsCtx turns on monitoring notifications, observing ctx's
+// cancellation and deadline. See UnmuteMonitors.
+func (client *Client) UnmuteMonitorsCtx(ctx context.Context) error {
+	return client.doJSONRequestCtx(ctx, "POST", "/v1/monitor/unmute_all", nil, nil)
+}
+
+// MuteMonitorCtx turns off monitoring notifications for a monitor, observing
+// ctx's cancellation and deadline. See MuteMonitor.
+func (client *Client) MuteMonitorCtx(ctx context.Context, id int, opts ...MuteMonitorOptions) error {
+	uri := fmt.Sprintf("/v1/monitor/%d/mute", id)
+	if len(opts) == 0 {
+		// Passing a typed nil *MuteMonitorOptions here would box a non-nil
+		// interface value, causing doJSONRequestCtx to marshal and send a
+		// literal "null" body instead of none at all.
+		return client.doJSONRequestCtx(ctx, "POST", uri, nil, nil)
+	}
+	return client.doJSONRequestCtx(ctx, "POST", uri, &opts[0], nil)
+}
+
+// UnmuteMonitorCtx turns on monitoring notifications for a monitor, observing
+// ctx's cancellation and deadline. See UnmuteMonitor.
+func (client *Client) UnmuteMonitorCtx(ctx context.Context, id int) error {
+	return client.doJSONRequestCtx(ctx, "POST", fmt.Sprintf("/v1/monitor/%d/unmute", id), nil, nil)
+}
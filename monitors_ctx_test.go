@@ -0,0 +1,89 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetMonitorCtxCanceledBeforeCall(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetMonitorCtx(ctx, 1)
+	if err == nil {
+		t.Fatal("expected an error from a pre-canceled ctx")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want it to wrap context.Canceled", err)
+	}
+	if called {
+		t.Error("server should not have been reached with a pre-canceled ctx")
+	}
+}
+
+func TestGetMonitorCtxDeadlineExceeded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetMonitorCtx(ctx, 1)
+	if err == nil {
+		t.Fatal("expected an error once the deadline is exceeded")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want it to wrap context.DeadlineExceeded", err)
+	}
+}
+
+func TestCreateMonitorAndCreateMonitorCtxAreEquivalent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": 7}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	name := "m"
+	viaBackground, err := client.CreateMonitor(&Monitor{Name: &name})
+	if err != nil {
+		t.Fatalf("CreateMonitor: %v", err)
+	}
+	viaCtx, err := client.CreateMonitorCtx(context.Background(), &Monitor{Name: &name})
+	if err != nil {
+		t.Fatalf("CreateMonitorCtx: %v", err)
+	}
+	if *viaBackground.ID != *viaCtx.ID {
+		t.Errorf("CreateMonitor and CreateMonitorCtx disagree: %d vs %d", *viaBackground.ID, *viaCtx.ID)
+	}
+}
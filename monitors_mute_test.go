@@ -0,0 +1,66 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMuteMonitorNoOptionsSendsNoBody(t *testing.T) {
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+		gotBody = b
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	if err := client.MuteMonitor(123); err != nil {
+		t.Fatalf("MuteMonitor: %v", err)
+	}
+
+	if len(gotBody) != 0 {
+		t.Errorf("body = %q, want empty (zero-value call must send no body)", gotBody)
+	}
+}
+
+func TestMuteMonitorWithOptionsSendsScopeAndEnd(t *testing.T) {
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+		gotBody = b
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	end := int64(1700000000)
+	if err := client.MuteMonitorScope(123, "host:foo", &end); err != nil {
+		t.Fatalf("MuteMonitorScope: %v", err)
+	}
+
+	want := `{"scope":"host:foo","end":1700000000}`
+	if string(gotBody) != want {
+		t.Errorf("body = %q, want %q", gotBody, want)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func ptrString(s string) *string { return &s }
+func ptrBool(b bool) *bool       { return &b }
+func ptrInt(i int) *int          { return &i }
+
+// roundTrip marshals o to JSON and unmarshals the result into a fresh
+// Options, returning it for comparison against the original.
+func roundTrip(t *testing.T, o *Options) *Options {
+	t.Helper()
+	data, err := json.Marshal(o)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out Options
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	return &out
+}
+
+func TestOptionsJSONRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		opts *Options
+	}{
+		{
+			name: "QueryConfig",
+			opts: &Options{
+				QueryConfig: &QueryConfig{
+					ThresholdWindows: &ThresholdWindows{
+						TriggerWindow:  ptrString("last_15m"),
+						RecoveryWindow: ptrString("last_15m"),
+					},
+				},
+			},
+		},
+		{
+			name: "EnableLogsSample",
+			opts: &Options{EnableLogsSample: ptrBool(true)},
+		},
+		{
+			name: "GroupbySimpleMonitor",
+			opts: &Options{GroupbySimpleMonitor: ptrBool(true)},
+		},
+		{
+			name: "NotifyBy",
+			opts: &Options{NotifyBy: []string{"host", "env"}},
+		},
+		{
+			name: "OnMissingData",
+			opts: &Options{OnMissingData: ptrString("resolve")},
+		},
+		{
+			name: "NotificationPresetName",
+			opts: &Options{NotificationPresetName: ptrString("hide_all")},
+		},
+		{
+			name: "SchedulingOptions",
+			opts: &Options{
+				SchedulingOptions: &SchedulingOptions{
+					EvaluationWindow: &EvaluationWindow{
+						DayStarts:   ptrString("06:00"),
+						HourStarts:  ptrInt(6),
+						MonthStarts: ptrInt(1),
+					},
+				},
+			},
+		},
+		{
+			name: "Variables",
+			opts: &Options{
+				Variables: []MonitorFormulaVariable{
+					{Name: ptrString("query1"), Type: ptrString("cloud_cost")},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := roundTrip(t, c.opts)
+			if !reflect.DeepEqual(c.opts, got) {
+				t.Errorf("round-trip mismatch for %s:\n got:  %#v\n want: %#v", c.name, got, c.opts)
+			}
+		})
+	}
+}
+
+func TestNewAnomalyDetectionOptions(t *testing.T) {
+	opts := NewAnomalyDetectionOptions("last_15m", "last_5m")
+
+	got := roundTrip(t, opts)
+	if !reflect.DeepEqual(opts, got) {
+		t.Errorf("round-trip mismatch:\n got:  %#v\n want: %#v", got, opts)
+	}
+
+	if got.QueryConfig == nil || got.QueryConfig.ThresholdWindows == nil {
+		t.Fatal("expected QueryConfig.ThresholdWindows to be set")
+	}
+	if *got.QueryConfig.ThresholdWindows.TriggerWindow != "last_15m" {
+		t.Errorf("TriggerWindow = %q, want %q", *got.QueryConfig.ThresholdWindows.TriggerWindow, "last_15m")
+	}
+	if *got.QueryConfig.ThresholdWindows.RecoveryWindow != "last_5m" {
+		t.Errorf("RecoveryWindow = %q, want %q", *got.QueryConfig.ThresholdWindows.RecoveryWindow, "last_5m")
+	}
+}
@@ -0,0 +1,242 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ReconcileAction describes what ReconcileMonitors did, or would do, with a
+// single monitor.
+type ReconcileAction string
+
+// The actions a reconcile pass can take for a single monitor.
+const (
+	ReconcileActionCreate ReconcileAction = "create"
+	ReconcileActionUpdate ReconcileAction = "update"
+	ReconcileActionDelete ReconcileAction = "delete"
+	ReconcileActionNoop   ReconcileAction = "noop"
+)
+
+// ReconcileChange is a single planned or applied change produced by
+// ReconcileMonitors.
+type ReconcileChange struct {
+	Action  ReconcileAction
+	Key     string
+	Desired *Monitor
+	Current *Monitor
+}
+
+// ReconcileOptions configures a ReconcileMonitors pass.
+type ReconcileOptions struct {
+	// KeyTag identifies the existing monitors this reconcile pass owns,
+	// e.g. "managed-by:my-tool". Only monitors carrying this tag are
+	// considered for update or deletion.
+	KeyTag string
+	// MatchBy maps a monitor to the key used to pair desired monitors with
+	// existing ones. Defaults to the monitor's Name.
+	MatchBy func(*Monitor) string
+	// DiffIgnore lists additional top-level Monitor/Options JSON fields to
+	// ignore when deciding whether a monitor changed. "creator", "id", and
+	// "overall_state" are always ignored since they're server-populated.
+	DiffIgnore []string
+	// DryRun computes the planned changes without applying them.
+	DryRun bool
+	// Parallelism bounds how many create/update/delete calls run at once.
+	// Defaults to 1 (sequential).
+	Parallelism int
+}
+
+// ReconcileResult is the outcome of a ReconcileMonitors pass.
+type ReconcileResult struct {
+	// Planned holds every change ReconcileMonitors computed, regardless of
+	// DryRun.
+	Planned []ReconcileChange
+	// Applied holds the changes that were actually sent to the API. It is
+	// empty when DryRun is set.
+	Applied []ReconcileChange
+	// Errors maps a change's Key to the error encountered applying it.
+	Errors map[string]error
+}
+
+var defaultDiffIgnore = []string{"creator", "id", "overall_state"}
+
+// ReconcileMonitors brings the set of monitors tagged with opts.KeyTag in
+// line with desired. See ReconcileMonitorsCtx.
+func (client *Client) ReconcileMonitors(desired []Monitor, opts ReconcileOptions) (*ReconcileResult, error) {
+	return client.ReconcileMonitorsCtx(context.Background(), desired, opts)
+}
+
+// ReconcileMonitorsCtx brings the set of monitors tagged with opts.KeyTag in
+// line with desired: monitors present in desired but not found by key are
+// created, monitors found on both sides with differing content are updated,
+// and tagged monitors missing from desired are deleted. Comparison ignores
+// server-populated fields (see ReconcileOptions.DiffIgnore) so round-tripped
+// monitors don't show up as spurious diffs. With opts.DryRun, changes are
+// computed but not applied. ctx bounds the whole pass, including every
+// create/update/delete call run across opts.Parallelism goroutines, which
+// matters for a long-running controller reconciling hundreds of monitors.
+func (client *Client) ReconcileMonitorsCtx(ctx context.Context, desired []Monitor, opts ReconcileOptions) (*ReconcileResult, error) {
+	matchBy := opts.MatchBy
+	if matchBy == nil {
+		matchBy = func(m *Monitor) string {
+			if m.Name == nil {
+				return ""
+			}
+			return *m.Name
+		}
+	}
+
+	existing, err := client.GetMonitorsByTagsCtx(ctx, []string{opts.KeyTag})
+	if err != nil {
+		return nil, fmt.Errorf("reconcile: fetching existing monitors: %w", err)
+	}
+
+	existingByKey := make(map[string]*Monitor, len(existing))
+	for i := range existing {
+		existingByKey[matchBy(&existing[i])] = &existing[i]
+	}
+
+	ignore := append(append([]string{}, defaultDiffIgnore...), opts.DiffIgnore...)
+
+	var changes []ReconcileChange
+	seen := make(map[string]bool, len(desired))
+	for i := range desired {
+		d := &desired[i]
+		key := matchBy(d)
+		seen[key] = true
+
+		cur, ok := existingByKey[key]
+		if !ok {
+			changes = append(changes, ReconcileChange{Action: ReconcileActionCreate, Key: key, Desired: d})
+			continue
+		}
+
+		same, err := monitorsEqualIgnoring(d, cur, ignore)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile: diffing monitor %q: %w", key, err)
+		}
+		if same {
+			changes = append(changes, ReconcileChange{Action: ReconcileActionNoop, Key: key, Desired: d, Current: cur})
+			continue
+		}
+
+		update := *d
+		update.ID = cur.ID
+		changes = append(changes, ReconcileChange{Action: ReconcileActionUpdate, Key: key, Desired: &update, Current: cur})
+	}
+
+	for key, cur := range existingByKey {
+		if !seen[key] {
+			changes = append(changes, ReconcileChange{Action: ReconcileActionDelete, Key: key, Current: cur})
+		}
+	}
+
+	result := &ReconcileResult{Planned: changes, Errors: map[string]error{}}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for _, change := range changes {
+		if change.Action == ReconcileActionNoop {
+			continue
+		}
+		if ctx.Err() != nil {
+			mu.Lock()
+			result.Errors[change.Key] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		change := change
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := client.applyReconcileChange(ctx, change); err != nil {
+				mu.Lock()
+				result.Errors[change.Key] = err
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			result.Applied = append(result.Applied, change)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return result, nil
+}
+
+func (client *Client) applyReconcileChange(ctx context.Context, change ReconcileChange) error {
+	switch change.Action {
+	case ReconcileActionCreate:
+		_, err := client.CreateMonitorCtx(ctx, change.Desired)
+		return err
+	case ReconcileActionUpdate:
+		return client.UpdateMonitorCtx(ctx, change.Desired)
+	case ReconcileActionDelete:
+		return client.DeleteMonitorCtx(ctx, *change.Current.ID)
+	default:
+		return nil
+	}
+}
+
+// monitorsEqualIgnoring compares two monitors for semantic equality,
+// ignoring the given top-level JSON fields of the monitor and of its options.
+func monitorsEqualIgnoring(a, b *Monitor, ignore []string) (bool, error) {
+	na, err := normalizeMonitor(a, ignore)
+	if err != nil {
+		return false, err
+	}
+	nb, err := normalizeMonitor(b, ignore)
+	if err != nil {
+		return false, err
+	}
+	return na == nb, nil
+}
+
+func normalizeMonitor(m *Monitor, ignore []string) (string, error) {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", err
+	}
+	options, _ := fields["options"].(map[string]interface{})
+	for _, field := range ignore {
+		delete(fields, field)
+		delete(options, field)
+	}
+
+	normalized, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
@@ -0,0 +1,144 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// reconcileFakeServer is a minimal in-memory /v1/monitor backend good enough
+// to exercise ReconcileMonitors' create/update/delete paths.
+type reconcileFakeServer struct {
+	mu       sync.Mutex
+	monitors map[int]Monitor
+	nextID   int
+}
+
+func newReconcileFakeServer(existing ...Monitor) *httptest.Server {
+	f := &reconcileFakeServer{monitors: map[int]Monitor{}, nextID: 1}
+	for _, m := range existing {
+		m := m
+		id := f.nextID
+		f.nextID++
+		m.ID = &id
+		f.monitors[id] = m
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/monitor":
+			var out []Monitor
+			for _, m := range f.monitors {
+				out = append(out, m)
+			}
+			json.NewEncoder(w).Encode(out)
+		case r.Method == "POST" && r.URL.Path == "/v1/monitor":
+			var m Monitor
+			json.NewDecoder(r.Body).Decode(&m)
+			id := f.nextID
+			f.nextID++
+			m.ID = &id
+			f.monitors[id] = m
+			json.NewEncoder(w).Encode(m)
+		case r.Method == "PUT":
+			var m Monitor
+			json.NewDecoder(r.Body).Decode(&m)
+			f.monitors[*m.ID] = m
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "DELETE":
+			id, err := strconv.Atoi(path.Base(r.URL.Path))
+			if err == nil {
+				delete(f.monitors, id)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestReconcileMonitorsCreatesMissingMonitors(t *testing.T) {
+	server := newReconcileFakeServer()
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	name := "new monitor"
+	desired := []Monitor{{Name: &name}}
+
+	result, err := client.ReconcileMonitors(desired, ReconcileOptions{KeyTag: "managed-by:test"})
+	if err != nil {
+		t.Fatalf("ReconcileMonitors: %v", err)
+	}
+
+	if len(result.Applied) != 1 || result.Applied[0].Action != ReconcileActionCreate {
+		t.Fatalf("Applied = %+v, want a single create", result.Applied)
+	}
+}
+
+func TestReconcileMonitorsDryRunAppliesNothing(t *testing.T) {
+	server := newReconcileFakeServer()
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	name := "new monitor"
+	desired := []Monitor{{Name: &name}}
+
+	result, err := client.ReconcileMonitors(desired, ReconcileOptions{KeyTag: "managed-by:test", DryRun: true})
+	if err != nil {
+		t.Fatalf("ReconcileMonitors: %v", err)
+	}
+
+	if len(result.Applied) != 0 {
+		t.Errorf("Applied = %+v, want none under DryRun", result.Applied)
+	}
+	if len(result.Planned) != 1 || result.Planned[0].Action != ReconcileActionCreate {
+		t.Errorf("Planned = %+v, want a single planned create", result.Planned)
+	}
+}
+
+// TestReconcileMonitorsCtxCanceled confirms ReconcileMonitorsCtx actually
+// threads ctx through to the underlying HTTP calls: a pre-canceled ctx must
+// fail the very first request (fetching existing monitors) rather than
+// silently running the whole reconcile pass against context.Background().
+func TestReconcileMonitorsCtxCanceled(t *testing.T) {
+	server := newReconcileFakeServer()
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	name := "new monitor"
+	desired := []Monitor{{Name: &name}}
+
+	_, err := client.ReconcileMonitorsCtx(ctx, desired, ReconcileOptions{KeyTag: "managed-by:test"})
+	if err == nil {
+		t.Fatal("expected an error from a pre-canceled ctx, got nil")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want it to wrap context.Canceled", err)
+	}
+}
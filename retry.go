@@ -0,0 +1,109 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether a failed HTTP request should be retried and,
+// if so, how long to wait before the next attempt. doJSONRequest consults
+// the client's configured RetryPolicy after every failed attempt (a
+// transport error or a status of 400 or above), which lets
+// callers drop in something like cenkalti/backoff instead of the built-in
+// ExponentialBackoff.
+type RetryPolicy interface {
+	// ShouldRetry is called after a request attempt with the HTTP response
+	// (nil on transport error) and the error doJSONRequest would otherwise
+	// return. It returns whether to retry and how long to wait first.
+	ShouldRetry(attempt int, resp *http.Response, err error) (bool, time.Duration)
+}
+
+// ExponentialBackoff is the default RetryPolicy: it retries on 429 and 5xx
+// responses (honoring a `Retry-After` header when present) and on transport
+// errors, backing off exponentially with jitter up to MaxAttempts.
+type ExponentialBackoff struct {
+	// MaxAttempts caps the number of attempts, including the first. Zero
+	// means the default of 3.
+	MaxAttempts int
+	// BaseDelay bounds the jittered delay before the first retry; each later
+	// retry doubles the bound. Zero means 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay. Zero means 30s.
+	MaxDelay time.Duration
+	// OnRetry, if set, is called before each retry with the attempt number
+	// (1-indexed), the error that triggered it, and the wait before the
+	// next attempt, giving callers per-attempt observability.
+	OnRetry func(attempt int, err error, wait time.Duration)
+}
+
+// ShouldRetry implements RetryPolicy.
+func (b *ExponentialBackoff) ShouldRetry(attempt int, resp *http.Response, err error) (bool, time.Duration) {
+	maxAttempts := b.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = 3
+	}
+	if attempt >= maxAttempts {
+		return false, 0
+	}
+
+	retryable := err != nil
+	if resp != nil {
+		retryable = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	}
+	if !retryable {
+		return false, 0
+	}
+
+	wait := b.nextDelay(attempt, resp)
+	if b.OnRetry != nil {
+		b.OnRetry(attempt, err, wait)
+	}
+	return true, wait
+}
+
+func (b *ExponentialBackoff) nextDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if after := resp.Header.Get("Retry-After"); after != "" {
+			if secs, err := strconv.Atoi(after); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	base := b.BaseDelay
+	if base == 0 {
+		base = 500 * time.Millisecond
+	}
+	maxDelay := b.MaxDelay
+	if maxDelay == 0 {
+		maxDelay = 30 * time.Second
+	}
+
+	delay := time.Duration(float64(base) * math.Pow(2, float64(attempt-1)))
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	// Full jitter: spread retries out so a burst of clients doesn't
+	// re-synchronize on the same backoff schedule.
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// WithRetryPolicy configures the RetryPolicy doJSONRequest uses for every
+// subsequent request on this client and returns the client for chaining.
+// The default, used when none is configured, is an *ExponentialBackoff with
+// its zero-value settings.
+func (client *Client) WithRetryPolicy(policy RetryPolicy) *Client {
+	client.retryPolicy = policy
+	return client
+}
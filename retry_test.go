@@ -0,0 +1,134 @@
+/*
+ * Datadog API for Go
+ *
+ * Please see the included LICENSE file for licensing information.
+ *
+ * Copyright 2013 by authors and contributors.
+ */
+
+package datadog
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoJSONRequestRetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+	client.WithRetryPolicy(&ExponentialBackoff{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+
+	if err := client.MuteMonitorScope(1, "host:foo", nil); err != nil {
+		t.Fatalf("MuteMonitorScope: %v", err)
+	}
+
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", attempts)
+	}
+	for i, b := range bodies {
+		if b != bodies[0] {
+			t.Errorf("attempt %d body = %q, want identical to first attempt %q", i, b, bodies[0])
+		}
+	}
+}
+
+func TestDoJSONRequestGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+	client.WithRetryPolicy(&ExponentialBackoff{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+
+	err := client.MuteMonitors()
+	if err == nil {
+		t.Fatal("expected an error once MaxAttempts is exhausted")
+	}
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("err = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusTooManyRequests)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2 (MaxAttempts)", attempts)
+	}
+}
+
+func TestDoJSONRequestHonorsRetryAfterHeader(t *testing.T) {
+	var attempts int
+	var firstAttemptAt time.Time
+	var secondAttemptAt time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			firstAttemptAt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		secondAttemptAt = time.Now()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+	client.WithRetryPolicy(&ExponentialBackoff{MaxAttempts: 3})
+
+	if err := client.MuteMonitors(); err != nil {
+		t.Fatalf("MuteMonitors: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+	if wait := secondAttemptAt.Sub(firstAttemptAt); wait < 900*time.Millisecond {
+		t.Errorf("retry fired after %s, want to honor the 1s Retry-After header", wait)
+	}
+}
+
+func TestDefaultRetryPolicyIsUsedWhenNoneConfigured(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", "test-app-key")
+	client.baseUrl = server.URL
+
+	if err := client.MuteMonitors(); err != nil {
+		t.Fatalf("MuteMonitors: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2 (default ExponentialBackoff should retry once)", attempts)
+	}
+}